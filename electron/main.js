@@ -1,6 +1,6 @@
 // main.js
 
-import { app, BrowserWindow, Tray, ipcMain } from "electron";
+import { app, BrowserWindow, Notification, Tray, ipcMain } from "electron";
 import path from "path";
 import fs from "fs";
 import * as utils from "./utils.js";
@@ -10,6 +10,7 @@ let window;
 let worker;
 let workerStatus = utils.status.STOPPED;
 let saveRecording = false;
+let skipEmptyTranscripts = true;
 
 function createWindow() {
   window = new BrowserWindow({
@@ -35,6 +36,11 @@ function createWindow() {
   // TODO: implement tray behavior
 }
 
+function notify(body) {
+  if (!Notification.isSupported()) return;
+  new Notification({ title: "Dictator", body }).show();
+}
+
 function createWorker() {
   worker = utils.spawnWorker();
 
@@ -48,10 +54,24 @@ function createWorker() {
       window?.webContents.send("worker-ready", workerStatus);
     }
 
-    if (message.startsWith("[transcription]")) {
-      const transcription = message.replace("[transcription]", "").trim();
-      window?.webContents.send("transcription", transcription);
+    if (message.startsWith("[error]")) {
+      const error = message.replace("[error]", "").trim();
+      console.error(`Worker error: ${error}`);
+      notify(error);
+      return;
     }
+
+    const transcription = utils.parseTranscript(message);
+    if (transcription === null) return;
+
+    // whisper returns an empty string when the clip has no speech
+    if (!transcription && skipEmptyTranscripts) {
+      console.log("No speech detected, skipping transcription");
+      notify("No speech detected");
+      return;
+    }
+
+    window?.webContents.send("transcription", transcription);
   });
 
   worker.stderr.on("data", (data) => {
@@ -87,20 +107,33 @@ function sendToWorker(audioBuffer) {
   });
 }
 
-app.whenReady().then(() => {
-  createWorker();
-  createWindow();
-
-  ipcMain.handle("check-worker", async () => {
-    return workerStatus;
+// only one instance may run, otherwise each would spawn its own worker
+if (!app.requestSingleInstanceLock()) {
+  console.log("Dictator is already running; exiting");
+  app.quit();
+} else {
+  app.on("second-instance", () => {
+    if (window) {
+      if (window.isMinimized()) window.restore();
+      window.focus();
+    }
   });
 
-  ipcMain.handle("transcribe", async (event, audioBuffer) => {
-    sendToWorker(audioBuffer);
-  });
+  app.whenReady().then(() => {
+    createWorker();
+    createWindow();
 
-  window?.webContents.send("worker-ready", workerStatus);
-});
+    ipcMain.handle("check-worker", async () => {
+      return workerStatus;
+    });
+
+    ipcMain.handle("transcribe", async (event, audioBuffer) => {
+      sendToWorker(audioBuffer);
+    });
+
+    window?.webContents.send("worker-ready", workerStatus);
+  });
+}
 
 app.on("window-all-closed", () => {
   if (process.platform !== "darwin") {
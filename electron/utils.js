@@ -58,6 +58,13 @@ export function deleteAllRecordings() {
   }
 }
 
+// Extracts the text from a worker "[transcript] ..." line. Returns null when
+// the line is not a transcript and an empty string when no speech was heard.
+export function parseTranscript(message) {
+  if (!message.startsWith("[transcript]")) return null;
+  return message.slice("[transcript]".length).trim();
+}
+
 export function spawnWorker() {
   const workerPath = path.join(getDirname(), "whisper", "worker.py");
   const worker = spawn(workerPath, [], { shell: true });
@@ -22,6 +22,15 @@ logger.add(
 
 MODEL = "distil-whisper/distil-small.en"
 
+# clips longer than CHUNK_LENGTH_S are split into windows that overlap by
+# STRIDE_LENGTH_S on each side, and the overlaps are merged when stitching;
+# 15s is the chunk length recommended for distil-whisper. windows are batched
+# on the GPU only, on the CPU a large batch just multiplies peak memory.
+CHUNK_LENGTH_S = 15
+STRIDE_LENGTH_S = 2.5
+BATCH_SIZE_GPU = 16
+BATCH_SIZE_CPU = 1
+
 
 def check_cuda() -> bool:
     """Check if CUDA is available."""
@@ -36,12 +45,10 @@ def check_cuda() -> bool:
 def load_model() -> Optional[Callable]:
     """Load model onto GPU."""
 
-    # TODO: rn this only handles short (<30s) clips efficiently
-    # TODO: implement the chunking strategy in distil-whisper README
-
     try:
         device = "cuda:0" if check_cuda() else "cpu"
         torch_dtype = torch.float16 if check_cuda() else torch.float32
+        batch_size = BATCH_SIZE_GPU if check_cuda() else BATCH_SIZE_CPU
 
         model = AutoModelForSpeechSeq2Seq.from_pretrained(
             MODEL, torch_dtype=torch_dtype, low_cpu_mem_usage=True, use_safetensors=True
@@ -56,6 +63,9 @@ def load_model() -> Optional[Callable]:
             tokenizer=processor.tokenizer,
             feature_extractor=processor.feature_extractor,
             max_new_tokens=128,
+            chunk_length_s=CHUNK_LENGTH_S,
+            stride_length_s=STRIDE_LENGTH_S,
+            batch_size=batch_size,
             torch_dtype=torch_dtype,
             device=device,
         )
@@ -75,7 +85,7 @@ def transcribe(pipe, audiofile):
         return result["text"], time.time() - tic
     except Exception as e:
         logger.error(f"Error during transcription: {e}")
-        return "[error] Transcription failed.", 0.0
+        return None, 0.0
 
 
 def print_(*args, **kwargs):
@@ -104,6 +114,10 @@ if __name__ == "__main__":
                     tic = time.time()
 
                     transcript, duration = transcribe(pipe, audiofile)
+                    if transcript is None:
+                        print_("[error] Transcription failed.")
+                        continue
+
                     print_transcript(transcript, duration)
 
                     logger.info(f"Transcribed {audiofile} in {time.time() - tic:.2f}s")